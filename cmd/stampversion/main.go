@@ -0,0 +1,98 @@
+// Command stampversion shells out to git and prints the `-ldflags`
+// string needed to stamp the internal/version package with the
+// current tag, commit, build date, and tree state, e.g.:
+//
+//	go build -ldflags "$(go run ./cmd/stampversion)" ./...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const defaultPkg = "github.com/Jordan-Cartwright/go-build-script/internal/version"
+
+func main() {
+	pkg := flag.String("pkg", defaultPkg, "import path of the version package to stamp")
+	flag.Parse()
+
+	flags, err := ldflags(*pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "stampversion:", err)
+		os.Exit(1)
+	}
+	fmt.Println(flags)
+}
+
+// ldflags builds the `-X` flag list by inspecting the local git
+// checkout, following the argo-cd convention of deriving version
+// metadata from `git describe`, `git rev-parse`, and the tree state.
+func ldflags(pkg string) (string, error) {
+	tag, err := git("describe", "--tags", "--dirty")
+	if err != nil {
+		tag = "unknown"
+	}
+
+	commit, err := git("rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("resolving commit: %w", err)
+	}
+
+	buildDate, err := git("log", "-1", "--format=%cI")
+	if err != nil {
+		return "", fmt.Errorf("resolving build date: %w", err)
+	}
+
+	status, err := git("status", "--porcelain")
+	if err != nil {
+		return "", fmt.Errorf("resolving tree state: %w", err)
+	}
+	treeState := treeStateFromPorcelain(status)
+
+	version := versionFromTag(tag)
+
+	xflags := []string{
+		ldflag(pkg, "version", version),
+		ldflag(pkg, "gitTag", tag),
+		ldflag(pkg, "gitCommit", commit),
+		ldflag(pkg, "buildDate", buildDate),
+		ldflag(pkg, "gitTreeState", treeState),
+	}
+	return strings.Join(xflags, " "), nil
+}
+
+func ldflag(pkg, name, value string) string {
+	return fmt.Sprintf("-X %s.%s=%s", pkg, name, value)
+}
+
+// versionFromTag strips the "-dirty" suffix `git describe --dirty`
+// appends, so the stamped version is a clean semver even when the
+// working tree has uncommitted changes (tree state is reported
+// separately via gitTreeState).
+func versionFromTag(tag string) string {
+	return strings.TrimSuffix(tag, "-dirty")
+}
+
+// treeStateFromPorcelain maps `git status --porcelain` output to
+// "clean" or "dirty": empty output means a clean tree.
+func treeStateFromPorcelain(status string) string {
+	if status != "" {
+		return "dirty"
+	}
+	return "clean"
+}
+
+func git(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, out.String())
+	}
+	return strings.TrimSpace(out.String()), nil
+}
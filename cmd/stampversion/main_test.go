@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestVersionFromTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"v1.2.3", "v1.2.3"},
+		{"v1.2.3-dirty", "v1.2.3"},
+		{"v1.2.3-4-gabcdef0", "v1.2.3-4-gabcdef0"},
+		{"v1.2.3-4-gabcdef0-dirty", "v1.2.3-4-gabcdef0"},
+		{"unknown", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := versionFromTag(tt.tag); got != tt.want {
+				t.Errorf("versionFromTag(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTreeStateFromPorcelain(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   string
+	}{
+		{"empty", "", "clean"},
+		{"modified file", " M main.go\n", "dirty"},
+		{"untracked file", "?? new_file.go\n", "dirty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := treeStateFromPorcelain(tt.status); got != tt.want {
+				t.Errorf("treeStateFromPorcelain(%q) = %q, want %q", tt.status, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package version
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// componentVersionOverrides is a comma-separated list of
+// "name=version" pairs, stamped at link time to override the default
+// version registered for one or more components, e.g.:
+//
+//	-X .../version.componentVersionOverrides=foo=1.2.3,bar=4.5.6
+//
+// This mirrors tinygo's approach to overriding runtime.Version(): a
+// single linker-settable var, parsed lazily, rather than one var per
+// component (which -X can't create on the fly).
+var componentVersionOverrides string
+
+var (
+	componentsMu      sync.RWMutex
+	componentsDefault = map[string]string{}
+
+	overridesOnce sync.Once
+	overrides     map[string]string
+)
+
+// RegisterComponent records the default version for a named
+// subsystem or embedded dependency, to be reported alongside this
+// binary's own version. Call it from an init() in the package that
+// owns the component.
+func RegisterComponent(name, defaultVersion string) {
+	componentsMu.Lock()
+	defer componentsMu.Unlock()
+	componentsDefault[name] = defaultVersion
+}
+
+// GetComponentVersion returns the linker-overridden version for name
+// if componentVersionOverrides set one, otherwise the default passed
+// to RegisterComponent, otherwise "".
+func GetComponentVersion(name string) string {
+	overridesOnce.Do(parseComponentVersionOverrides)
+
+	if v, ok := overrides[name]; ok {
+		return v
+	}
+
+	componentsMu.RLock()
+	defer componentsMu.RUnlock()
+	return componentsDefault[name]
+}
+
+func parseComponentVersionOverrides() {
+	overrides = map[string]string{}
+	if componentVersionOverrides == "" {
+		return
+	}
+	for _, pair := range strings.Split(componentVersionOverrides, ",") {
+		name, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		overrides[name] = v
+	}
+}
+
+// goVersion overrides GetGoVersion when set at link time, for cases
+// (e.g. a tinygo build) where runtime.Version() doesn't reflect the
+// toolchain a consumer cares about.
+var goVersion string
+
+// GetGoVersion returns the goVersion ldflag when set, otherwise
+// runtime.Version().
+func GetGoVersion() string {
+	if goVersion != "" {
+		return goVersion
+	}
+	return runtime.Version()
+}
@@ -0,0 +1,67 @@
+package version
+
+import (
+	"sync"
+	"testing"
+)
+
+// resetOverrides forces the next GetComponentVersion call to
+// re-parse componentVersionOverrides, without copying the
+// package-level sync.Once (copying it would trip `go vet`'s
+// copylocks check).
+func resetOverrides() {
+	overridesOnce = sync.Once{}
+	overrides = nil
+}
+
+func TestGetComponentVersion(t *testing.T) {
+	restoreDefaults := componentsDefault
+	restoreOverride := componentVersionOverrides
+	defer func() {
+		componentsDefault = restoreDefaults
+		componentVersionOverrides = restoreOverride
+		resetOverrides()
+	}()
+
+	componentsDefault = map[string]string{}
+	componentVersionOverrides = "foo=1.2.3,bar=4.5.6"
+	resetOverrides()
+
+	RegisterComponent("foo", "0.0.0-default")
+	RegisterComponent("baz", "1.0.0-default")
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"foo", "1.2.3"},         // overridden
+		{"baz", "1.0.0-default"}, // default, no override
+		{"nope", ""},             // never registered, no override
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetComponentVersion(tt.name); got != tt.want {
+				t.Errorf("GetComponentVersion(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseComponentVersionOverridesIgnoresMalformedPairs(t *testing.T) {
+	restoreOverride := componentVersionOverrides
+	defer func() {
+		componentVersionOverrides = restoreOverride
+		resetOverrides()
+	}()
+
+	componentVersionOverrides = "foo=1.2.3,malformed,bar="
+	resetOverrides()
+
+	if got := GetComponentVersion("foo"); got != "1.2.3" {
+		t.Errorf(`GetComponentVersion("foo") = %q, want "1.2.3"`, got)
+	}
+	if got := GetComponentVersion("bar"); got != "" {
+		t.Errorf(`GetComponentVersion("bar") = %q, want ""`, got)
+	}
+}
@@ -0,0 +1,114 @@
+package version
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime"
+)
+
+var (
+	// prerelease is appended to version as `-prerelease`, e.g. "beta1".
+	// Deliberately uninitialized, see GetPrerelease().
+	prerelease string
+
+	// versionMetadata is appended to version as `+versionMetadata`, per
+	// semver's build metadata suffix. Deliberately uninitialized, see
+	// GetVersionMetadata().
+	versionMetadata string
+)
+
+func GetPrerelease() string {
+	return prerelease
+}
+
+func GetVersionMetadata() string {
+	return versionMetadata
+}
+
+// GetHumanVersion composes Version[-Prerelease][+Metadata], following
+// semver §10 (https://semver.org/#spec-item-10).
+func GetHumanVersion() string {
+	v := GetVersion()
+	if pre := GetPrerelease(); pre != "" {
+		v = fmt.Sprintf("%s-%s", v, pre)
+	}
+	if meta := GetVersionMetadata(); meta != "" {
+		v = fmt.Sprintf("%s+%s", v, meta)
+	}
+	return v
+}
+
+// Info is a structured, JSON- and YAML-friendly snapshot of
+// everything this package knows about how the running binary was
+// built.
+type Info struct {
+	Version      string `json:"version" yaml:"version"`
+	Prerelease   string `json:"prerelease,omitempty" yaml:"prerelease,omitempty"`
+	Metadata     string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	GitCommit    string `json:"gitCommit" yaml:"gitCommit"`
+	GitTag       string `json:"gitTag,omitempty" yaml:"gitTag,omitempty"`
+	GitTreeState string `json:"gitTreeState" yaml:"gitTreeState"`
+	BuildDate    string `json:"buildDate" yaml:"buildDate"`
+	GoVersion    string `json:"goVersion" yaml:"goVersion"`
+	Compiler     string `json:"compiler" yaml:"compiler"`
+	Platform     string `json:"platform" yaml:"platform"`
+}
+
+// GetInfo returns an Info populated from the package's current
+// version, commit, and build-date state, plus the Go runtime.
+func GetInfo() Info {
+	return Info{
+		Version:      GetVersion(),
+		Prerelease:   GetPrerelease(),
+		Metadata:     GetVersionMetadata(),
+		GitCommit:    GetCommit(),
+		GitTag:       GetGitTag(),
+		GitTreeState: GetGitTreeState(),
+		BuildDate:    GetBuildDate(),
+		GoVersion:    GetGoVersion(),
+		Compiler:     runtime.Compiler,
+		Platform:     fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	}
+}
+
+// String renders a multi-line, human-readable summary of Info.
+func (i Info) String() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Version:    %s\n", GetHumanVersion())
+	if i.GitTag != "" {
+		fmt.Fprintf(&b, "Git tag:    %s\n", i.GitTag)
+	}
+	fmt.Fprintf(&b, "Git commit: %s\n", i.GitCommit)
+	fmt.Fprintf(&b, "Git state:  %s\n", i.GitTreeState)
+	fmt.Fprintf(&b, "Build date: %s\n", i.BuildDate)
+	fmt.Fprintf(&b, "Go version: %s\n", i.GoVersion)
+	fmt.Fprintf(&b, "Compiler:   %s\n", i.Compiler)
+	fmt.Fprintf(&b, "Platform:   %s\n", i.Platform)
+	return b.String()
+}
+
+// rendered is the on-the-wire shape of Info shared by MarshalJSON and
+// MarshalYAML, so both encoders agree on field names and on composing
+// Version from GetHumanVersion() rather than the raw, unadorned one.
+type rendered Info
+
+// MarshalJSON implements json.Marshaler.
+func (i Info) MarshalJSON() ([]byte, error) {
+	r := rendered(i)
+	r.Version = GetHumanVersion()
+	return json.Marshal(r)
+}
+
+// MarshalYAML implements yaml.Marshaler (gopkg.in/yaml.v3).
+func (i Info) MarshalYAML() (interface{}, error) {
+	r := rendered(i)
+	r.Version = GetHumanVersion()
+	return r, nil
+}
+
+// Short returns just the human-composed version string, e.g. for
+// embedding in a one-line `--version` reply.
+func (i Info) Short() string {
+	return GetHumanVersion()
+}
@@ -0,0 +1,43 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LDFlags generates the `-X` linker flags needed to stamp the given
+// Info onto every linker-settable var this package defines, so a
+// binary built with it reports the same fields cmd/stampversion
+// would. pkgImportPath is the fully-qualified import path of this
+// package as seen by the consuming module, e.g.
+// "github.com/you/yourtool/internal/version".
+//
+// It does not cover componentVersionOverrides (see RegisterComponent)
+// since that's keyed by component name rather than carried on Info.
+//
+// Typical Makefile usage:
+//
+//	LDFLAGS := $(shell go run ./cmd/stampversion -pkg github.com/you/yourtool/internal/version)
+//	go build -ldflags "$(LDFLAGS)" ./...
+func LDFlags(pkgImportPath string, v Info) []string {
+	return []string{
+		ldflag(pkgImportPath, "version", v.Version),
+		ldflag(pkgImportPath, "prerelease", v.Prerelease),
+		ldflag(pkgImportPath, "versionMetadata", v.Metadata),
+		ldflag(pkgImportPath, "gitCommit", v.GitCommit),
+		ldflag(pkgImportPath, "gitTag", v.GitTag),
+		ldflag(pkgImportPath, "gitTreeState", v.GitTreeState),
+		ldflag(pkgImportPath, "buildDate", v.BuildDate),
+		ldflag(pkgImportPath, "goVersion", v.GoVersion),
+	}
+}
+
+func ldflag(pkgImportPath, name, value string) string {
+	return fmt.Sprintf("-X %s.%s=%s", pkgImportPath, name, value)
+}
+
+// LDFlagsString joins LDFlags into a single string suitable for
+// passing directly to `go build -ldflags`.
+func LDFlagsString(pkgImportPath string, v Info) string {
+	return strings.Join(LDFlags(pkgImportPath, v), " ")
+}
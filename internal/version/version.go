@@ -2,14 +2,57 @@ package version
 
 import (
 	"fmt"
+	"runtime/debug"
 )
 
 var (
 	// Deliberately uninitialized, see GetVersion()
-	version   string
-	gitCommit string
+	version      string
+	gitCommit    string
+	gitTag       string
+	buildDate    string
+	gitTreeState string
 )
 
+// VCSInfo holds the version control metadata recovered from
+// runtime/debug.ReadBuildInfo() when the binary was built without
+// ldflags (e.g. a plain `go build` or `go install`).
+type VCSInfo struct {
+	Revision string
+	Time     string
+	Modified bool
+}
+
+// buildInfo returns the build info for the running binary, or nil if
+// it isn't available (e.g. when building without module support).
+func buildInfo() *debug.BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil
+	}
+	return info
+}
+
+// vcsInfo extracts VCSInfo from the build info's settings, if present.
+func vcsInfo() VCSInfo {
+	var info VCSInfo
+	bi := buildInfo()
+	if bi == nil {
+		return info
+	}
+	for _, setting := range bi.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Revision = setting.Value
+		case "vcs.time":
+			info.Time = setting.Value
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+	return info
+}
+
 func GetFullVersion() string {
 	return fmt.Sprintf("%s-%s", GetVersion(), GetCommit())
 }
@@ -18,6 +61,9 @@ func GetCommit() string {
 	if gitCommit != "" {
 		return gitCommit
 	}
+	if rev := vcsInfo().Revision; rev != "" {
+		return rev
+	}
 	return "unknown"
 }
 
@@ -25,5 +71,55 @@ func GetVersion() string {
 	if version != "" {
 		return version
 	}
+	if bi := buildInfo(); bi != nil && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		return bi.Main.Version
+	}
 	return "unknown"
 }
+
+// GetBuildDate returns the buildDate ldflag when set, falling back to
+// the commit timestamp recorded by the Go module build system
+// (vcs.time), and then to "unknown".
+func GetBuildDate() string {
+	if buildDate != "" {
+		return buildDate
+	}
+	if t := vcsInfo().Time; t != "" {
+		return t
+	}
+	return "unknown"
+}
+
+// GetGitTag returns the git tag this binary was built from, as
+// stamped by the gitTag ldflag. It returns "" when unset; callers that
+// want a tag-or-version fallback should use GetVersion().
+func GetGitTag() string {
+	return gitTag
+}
+
+// GetGitTreeState returns "clean" or "dirty" as stamped by the
+// gitTreeState ldflag, falling back to vcs.modified from the Go
+// module build system, and then to "unknown".
+func GetGitTreeState() string {
+	if gitTreeState != "" {
+		return gitTreeState
+	}
+	if bi := buildInfo(); bi != nil {
+		for _, setting := range bi.Settings {
+			if setting.Key == "vcs.modified" {
+				if setting.Value == "true" {
+					return "dirty"
+				}
+				return "clean"
+			}
+		}
+	}
+	return "unknown"
+}
+
+// IsDirty reports whether the binary was built from a working tree
+// with uncommitted changes, as recorded by vcs.modified. It returns
+// false if that information isn't available.
+func IsDirty() bool {
+	return vcsInfo().Modified
+}
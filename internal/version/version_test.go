@@ -0,0 +1,71 @@
+package version
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetHumanVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		prerelease string
+		metadata   string
+		want       string
+	}{
+		{"version only", "1.2.3", "", "", "1.2.3"},
+		{"with prerelease", "1.2.3", "beta1", "", "1.2.3-beta1"},
+		{"with metadata", "1.2.3", "", "abcd123", "1.2.3+abcd123"},
+		{"with both", "1.2.3", "beta1", "abcd123", "1.2.3-beta1+abcd123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := stash()
+			defer restore()
+
+			version = tt.version
+			prerelease = tt.prerelease
+			versionMetadata = tt.metadata
+
+			if got := GetHumanVersion(); got != tt.want {
+				t.Errorf("GetHumanVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInfoMarshalJSON(t *testing.T) {
+	restore := stash()
+	defer restore()
+
+	version = "1.2.3"
+	prerelease = "beta1"
+
+	b, err := json.Marshal(GetInfo())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got, want := m["version"], "1.2.3-beta1"; got != want {
+		t.Errorf(`m["version"] = %v, want %v`, got, want)
+	}
+	if _, ok := m["gitCommit"]; !ok {
+		t.Errorf("expected camelCase key %q in %v", "gitCommit", m)
+	}
+}
+
+// stash saves the package-level version vars and returns a func that
+// restores them, so tests can mutate global state without leaking
+// into other tests.
+func stash() func() {
+	v, p, m, c, gt, bd, gts, gv := version, prerelease, versionMetadata, gitCommit, gitTag, buildDate, gitTreeState, goVersion
+	return func() {
+		version, prerelease, versionMetadata, gitCommit, gitTag, buildDate, gitTreeState, goVersion = v, p, m, c, gt, bd, gts, gv
+	}
+}
@@ -0,0 +1,138 @@
+// Package versioncmd provides a ready-to-wire `--version`/`version`
+// surface backed by the internal/version package: Command() for
+// cobra-based CLIs, and VersionFlag() to wire a `--version` flag into
+// a plain `flag.FlagSet` in one line.
+package versioncmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Jordan-Cartwright/go-build-script/internal/version"
+)
+
+// OutputFormat selects how Command renders version information. It
+// implements flag.Value so it can also be wired into a plain
+// `flag.FlagSet` for non-cobra CLIs.
+type OutputFormat string
+
+const (
+	Short OutputFormat = "short"
+	Long  OutputFormat = "long"
+	JSON  OutputFormat = "json"
+	YAML  OutputFormat = "yaml"
+)
+
+func (f *OutputFormat) String() string {
+	if *f == "" {
+		return string(Short)
+	}
+	return string(*f)
+}
+
+func (f *OutputFormat) Set(s string) error {
+	switch OutputFormat(s) {
+	case Short, Long, JSON, YAML:
+		*f = OutputFormat(s)
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %q, must be one of: short, long, json, yaml", s)
+	}
+}
+
+func (f *OutputFormat) Type() string {
+	return "string"
+}
+
+// Write renders version.GetInfo() to w in the selected format.
+func Write(w io.Writer, format OutputFormat) error {
+	info := version.GetInfo()
+
+	switch format {
+	case Long:
+		_, err := io.WriteString(w, info.String())
+		return err
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(info)
+	case YAML:
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(info)
+	case Short, "":
+		_, err := fmt.Fprintln(w, info.Short())
+		return err
+	default:
+		return fmt.Errorf("invalid output format %q", format)
+	}
+}
+
+// Command returns a `version` cobra.Command that prints build version
+// information. Wire it in with rootCmd.AddCommand(versioncmd.Command()).
+func Command() *cobra.Command {
+	var output OutputFormat
+
+	cmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print version information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Write(cmd.OutOrStdout(), output)
+		},
+	}
+	cmd.Flags().VarP(&output, "output", "o", "Output format: short, long, json, yaml")
+	return cmd
+}
+
+// versionFlag is a flag.Value that, once set true, writes version
+// info and exits the process — the behavior a bare `--version` flag
+// is expected to have in a stdlib-`flag` CLI.
+type versionFlag struct {
+	w      io.Writer
+	format *OutputFormat
+}
+
+// IsBoolFlag lets `--version` be passed without an explicit argument,
+// matching the flag package's convention for boolean flags.
+func (v *versionFlag) IsBoolFlag() bool { return true }
+
+func (v *versionFlag) String() string { return "false" }
+
+func (v *versionFlag) Set(s string) error {
+	set, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	if !set {
+		return nil
+	}
+
+	format := Short
+	if v.format != nil {
+		format = *v.format
+	}
+	if err := Write(v.w, format); err != nil {
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
+
+// VersionFlag registers a `--version` flag on fs that, when passed,
+// writes version information to w (in the format pointed to by
+// format, or Short if format is nil) and exits the process:
+//
+//	var output versioncmd.OutputFormat
+//	flag.Var(&output, "output", "output format: short, long, json, yaml")
+//	versioncmd.VersionFlag(flag.CommandLine, os.Stdout, &output)
+//	flag.Parse()
+func VersionFlag(fs *flag.FlagSet, w io.Writer, format *OutputFormat) {
+	fs.Var(&versionFlag{w: w, format: format}, "version", "print version information and exit")
+}
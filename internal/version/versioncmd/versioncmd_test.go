@@ -0,0 +1,158 @@
+package versioncmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/exec"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestOutputFormatSet(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    OutputFormat
+		wantErr bool
+	}{
+		{"short", Short, false},
+		{"long", Long, false},
+		{"json", JSON, false},
+		{"yaml", YAML, false},
+		{"xml", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var f OutputFormat
+			err := f.Set(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Set(%q) = nil error, want error", tt.in)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set(%q) = %v, want nil", tt.in, err)
+			}
+			if f != tt.want {
+				t.Errorf("Set(%q): f = %q, want %q", tt.in, f, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputFormatStringDefaultsToShort(t *testing.T) {
+	var f OutputFormat
+	if got := f.String(); got != string(Short) {
+		t.Errorf("zero-value String() = %q, want %q", got, Short)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	for _, format := range []OutputFormat{Short, Long, JSON, YAML, ""} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, format); err != nil {
+				t.Fatalf("Write(%q) = %v, want nil", format, err)
+			}
+			if buf.Len() == 0 {
+				t.Errorf("Write(%q) produced no output", format)
+			}
+		})
+	}
+}
+
+func TestWriteInvalidFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, OutputFormat("bogus")); err == nil {
+		t.Fatal("Write(bogus) = nil error, want error")
+	}
+}
+
+// TestWriteJSONAndYAMLAgree guards against the json/yaml output
+// diverging the way it did before d9fb971, where yaml.v3 ignored the
+// json struct tags and silently dropped the composed prerelease.
+func TestWriteJSONAndYAMLAgree(t *testing.T) {
+	var jsonBuf, yamlBuf bytes.Buffer
+	if err := Write(&jsonBuf, JSON); err != nil {
+		t.Fatalf("Write(JSON) = %v", err)
+	}
+	if err := Write(&yamlBuf, YAML); err != nil {
+		t.Fatalf("Write(YAML) = %v", err)
+	}
+
+	var jsonOut map[string]interface{}
+	if err := json.Unmarshal(jsonBuf.Bytes(), &jsonOut); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	var yamlOut map[string]interface{}
+	if err := yaml.Unmarshal(yamlBuf.Bytes(), &yamlOut); err != nil {
+		t.Fatalf("yaml.Unmarshal: %v", err)
+	}
+
+	for _, key := range []string{"version", "gitCommit", "gitTreeState", "buildDate", "goVersion", "compiler", "platform"} {
+		if _, ok := jsonOut[key]; !ok {
+			t.Errorf("json output missing camelCase key %q: %v", key, jsonOut)
+		}
+		if _, ok := yamlOut[key]; !ok {
+			t.Errorf("yaml output missing camelCase key %q: %v", key, yamlOut)
+		}
+	}
+
+	if jsonOut["version"] != yamlOut["version"] {
+		t.Errorf("version mismatch: json=%v yaml=%v", jsonOut["version"], yamlOut["version"])
+	}
+}
+
+func TestCommandOutputJSON(t *testing.T) {
+	cmd := Command()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--output=json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &m); err != nil {
+		t.Fatalf("output isn't valid json: %v (%s)", err, out.String())
+	}
+}
+
+func TestCommandRejectsUnknownOutput(t *testing.T) {
+	cmd := Command()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--output=xml"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("Execute() with --output=xml = nil error, want error")
+	}
+}
+
+// TestVersionFlag exercises versionFlag.Set's os.Exit(0) path in a
+// subprocess, the standard pattern for testing flag/command handlers
+// that terminate the process.
+func TestVersionFlag(t *testing.T) {
+	if os.Getenv("VERSIONCMD_HELPER_PROCESS") == "1" {
+		fs := flag.NewFlagSet("helper", flag.ExitOnError)
+		VersionFlag(fs, os.Stdout, nil)
+		if err := fs.Parse([]string{"--version"}); err != nil {
+			os.Exit(2)
+		}
+		// versionFlag.Set exits before Parse returns; reaching here is a bug.
+		os.Exit(1)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestVersionFlag")
+	cmd.Env = append(os.Environ(), "VERSIONCMD_HELPER_PROCESS=1")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("helper process failed: %v", err)
+	}
+	if len(out) == 0 {
+		t.Error("VersionFlag produced no output")
+	}
+}